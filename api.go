@@ -1,67 +1,73 @@
 package main
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
-	"os"
 	"strconv"
 	"time"
 
-	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
+	"github.com/lib/pq"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/muhreeowki/go-bank-api/config"
+	db "github.com/muhreeowki/go-bank-api/db/sqlc"
+	"github.com/muhreeowki/go-bank-api/token"
+)
+
+// validate validates request structs against their `validate` struct tags.
+var validate = validator.New()
+
+// User roles. A new account's owner always gets RoleDepositor; RoleBanker
+// accounts are provisioned directly in the database.
+const (
+	RoleDepositor = "depositor"
+	RoleBanker    = "banker"
 )
 
 // APIServer is a struct that represents an API server
 type APIServer struct {
-	store      Storage
-	listenAddr string
+	store                db.Store
+	listenAddr           string
+	tokenMaker           token.TokenMaker
+	accessTokenDuration  time.Duration
+	refreshTokenDuration time.Duration
 }
 
 // NewAPIServer creates a new APIServer with the given listen address
-func NewAPIServer(listenAddr string, store Storage) *APIServer {
+func NewAPIServer(cfg config.Config, store db.Store, tokenMaker token.TokenMaker) *APIServer {
 	return &APIServer{
-		store:      store,
-		listenAddr: listenAddr,
+		store:                store,
+		listenAddr:           cfg.ServerAddress,
+		tokenMaker:           tokenMaker,
+		accessTokenDuration:  cfg.AccessTokenDuration,
+		refreshTokenDuration: cfg.RefreshTokenDuration,
 	}
 }
 
 func (s *APIServer) Run() {
 	router := mux.NewRouter()
-	router.HandleFunc("/account", makeHTTPHandlerFunc(s.handleAccount))
-	router.HandleFunc("/account/{id}", withJWTAuth(makeHTTPHandlerFunc(s.handleAccountByID)))
-	router.HandleFunc("/transfer", makeHTTPHandlerFunc(s.handleTransfer))
+	router.HandleFunc("/login", makeHTTPHandlerFunc(s.handleLogin))
+	router.HandleFunc("/tokens/renew", makeHTTPHandlerFunc(s.handleRenewToken))
+	router.HandleFunc("/account", makeHTTPHandlerFunc(s.handleCreateAccount)).Methods(http.MethodPost)
+	router.HandleFunc("/account", s.authRoles(makeHTTPHandlerFunc(s.handleGetAccounts), RoleBanker)).Methods(http.MethodGet)
+	router.HandleFunc("/account/{id}", s.withJWTAuth(makeHTTPHandlerFunc(s.handleGetAccountByID))).Methods(http.MethodGet)
+	router.HandleFunc("/account/{id}", s.authRoles(makeHTTPHandlerFunc(s.handleDeleteAccountByID), RoleBanker)).Methods(http.MethodDelete)
+	router.HandleFunc("/transfer", s.withJWTAuth(makeHTTPHandlerFunc(s.handleTransfer)))
 
 	log.Println("JSON API is running on port: ", s.listenAddr)
 
 	http.ListenAndServe(s.listenAddr, router)
 }
 
-func (s *APIServer) handleAccount(w http.ResponseWriter, r *http.Request) error {
-	switch r.Method {
-	case "GET":
-		return s.handleGetAccounts(w, r)
-	case "POST":
-		return s.handleCreateAccount(w, r)
-	default:
-		return fmt.Errorf("method not allowed: %s", r.Method)
-	}
-}
-
-func (s *APIServer) handleAccountByID(w http.ResponseWriter, r *http.Request) error {
-	switch r.Method {
-	case "GET":
-		return s.handleGetAccountByID(w, r)
-	case "DELETE":
-		return s.handleDeleteAccountByID(w, r)
-	default:
-		return fmt.Errorf("method not allowed: %s", r.Method)
-	}
-}
-
 func (s *APIServer) handleGetAccounts(w http.ResponseWriter, r *http.Request) error {
-	accounts, err := s.store.GetAccounts()
+	accounts, err := s.store.ListAccounts(r.Context())
 	if err != nil {
 		return err
 	}
@@ -73,10 +79,18 @@ func (s *APIServer) handleGetAccountByID(w http.ResponseWriter, r *http.Request)
 	if err != nil {
 		return err
 	}
-	account, err := s.store.GetAccountByID(id)
+	account, err := s.store.GetAccount(r.Context(), int64(id))
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return newAPIError(http.StatusNotFound, "account_not_found", err)
+		}
 		return err
 	}
+
+	if payload := authPayload(r); payload.Username != account.Owner {
+		return newAPIError(http.StatusForbidden, "unauthorized", fmt.Errorf("account does not belong to the authenticated user"))
+	}
+
 	return writeJSON(w, http.StatusOK, account)
 }
 
@@ -87,19 +101,28 @@ func (s *APIServer) handleCreateAccount(w http.ResponseWriter, r *http.Request)
 	}
 	defer r.Body.Close()
 
-	account := NewAccount(createAccountReq.FirstName, createAccountReq.LastName)
-	if err := s.store.CreateAccount(account); err != nil {
+	if err := validateStruct(createAccountReq); err != nil {
 		return err
 	}
 
-	// Generate JWT token
-	tokenString, err := createJWT(account)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(createAccountReq.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %s", err)
+	}
+
+	result, err := s.store.CreateUserTx(r.Context(), db.CreateUserTxParams{
+		CreateUserParams: db.CreateUserParams{
+			Username:       createAccountReq.Username,
+			HashedPassword: string(hashedPassword),
+			FullName:       createAccountReq.FullName,
+			Email:          createAccountReq.Email,
+		},
+	})
 	if err != nil {
 		return err
 	}
-	fmt.Println("JWT Token: ", tokenString)
 
-	return writeJSON(w, http.StatusCreated, account)
+	return writeJSON(w, http.StatusCreated, result.Account)
 }
 
 func (s *APIServer) handleDeleteAccountByID(w http.ResponseWriter, r *http.Request) error {
@@ -107,7 +130,8 @@ func (s *APIServer) handleDeleteAccountByID(w http.ResponseWriter, r *http.Reque
 	if err != nil {
 		return err
 	}
-	if err := s.store.DeleteAccount(id); err != nil {
+
+	if err := s.store.DeleteAccount(r.Context(), int64(id)); err != nil {
 		return err
 	}
 	return writeJSON(w, http.StatusOK, "successfully deleted account")
@@ -116,21 +140,217 @@ func (s *APIServer) handleDeleteAccountByID(w http.ResponseWriter, r *http.Reque
 func (s *APIServer) handleTransfer(w http.ResponseWriter, r *http.Request) error {
 	transferReq := new(TransferRequest)
 	if err := json.NewDecoder(r.Body).Decode(transferReq); err != nil {
-		return fmt.Errorf("bad Request: %s", err)
+		return fmt.Errorf("bad request: %s", err)
 	}
 	defer r.Body.Close()
 
-	// msg := fmt.Sprintf("successfully transfered amount %s to account %s", transferReq.Amount, transferReq.ToAccount)
-	return writeJSON(w, http.StatusOK, transferReq)
+	if err := validateStruct(transferReq); err != nil {
+		return err
+	}
+
+	if transferReq.FromAccount == transferReq.ToAccount {
+		return fmt.Errorf("cannot transfer to the same account")
+	}
+
+	fromAccount, err := s.store.GetAccount(r.Context(), transferReq.FromAccount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return newAPIError(http.StatusNotFound, "account_not_found", err)
+		}
+		return err
+	}
+
+	if payload := authPayload(r); payload.Username != fromAccount.Owner {
+		return newAPIError(http.StatusForbidden, "unauthorized", fmt.Errorf("source account does not belong to the authenticated user"))
+	}
+
+	result, err := s.store.TransferTx(r.Context(), db.TransferTxParams{
+		FromAccountID: transferReq.FromAccount,
+		ToAccountID:   transferReq.ToAccount,
+		Amount:        transferReq.Amount,
+		Currency:      transferReq.Currency,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return newAPIError(http.StatusUnprocessableEntity, "insufficient_funds", err)
+		}
+		if errors.Is(err, db.ErrCurrencyMismatch) {
+			return newAPIError(http.StatusUnprocessableEntity, "currency_mismatch", err)
+		}
+		return err
+	}
+
+	return writeJSON(w, http.StatusOK, result)
+}
+
+func (s *APIServer) handleLogin(w http.ResponseWriter, r *http.Request) error {
+	loginReq := new(LoginRequest)
+	if err := json.NewDecoder(r.Body).Decode(loginReq); err != nil {
+		return fmt.Errorf("bad request: %s", err)
+	}
+	defer r.Body.Close()
+
+	if err := validateStruct(loginReq); err != nil {
+		return err
+	}
+
+	user, err := s.store.GetUserByEmail(r.Context(), loginReq.Email)
+	if err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(loginReq.Password)); err != nil {
+		return fmt.Errorf("incorrect email or password")
+	}
+
+	accessToken, accessPayload, err := s.tokenMaker.CreateToken(user.Username, user.Role, s.accessTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	refreshToken, refreshPayload, err := s.tokenMaker.CreateToken(user.Username, user.Role, s.refreshTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.store.CreateSession(r.Context(), db.CreateSessionParams{
+		ID:           refreshPayload.ID,
+		Username:     user.Username,
+		RefreshToken: refreshToken,
+		UserAgent:    r.UserAgent(),
+		ClientIP:     r.RemoteAddr,
+		IsBlocked:    false,
+		ExpiresAt:    refreshPayload.ExpiredAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(w, http.StatusOK, &LoginResponse{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshPayload.ExpiredAt,
+		User:                  newUserResponse(user),
+	})
+}
+
+func (s *APIServer) handleRenewToken(w http.ResponseWriter, r *http.Request) error {
+	renewReq := new(RenewAccessTokenRequest)
+	if err := json.NewDecoder(r.Body).Decode(renewReq); err != nil {
+		return fmt.Errorf("bad request: %s", err)
+	}
+	defer r.Body.Close()
+
+	refreshPayload, err := s.tokenMaker.VerifyToken(renewReq.RefreshToken)
+	if err != nil {
+		return fmt.Errorf("invalid refresh token")
+	}
+
+	session, err := s.store.GetSession(r.Context(), refreshPayload.ID)
+	if err != nil {
+		return err
+	}
+	if session.IsBlocked {
+		return fmt.Errorf("session has been blocked")
+	}
+	if session.RefreshToken != renewReq.RefreshToken {
+		return fmt.Errorf("mismatched session token")
+	}
+	if session.Username != refreshPayload.Username {
+		return fmt.Errorf("mismatched session user")
+	}
+
+	accessToken, accessPayload, err := s.tokenMaker.CreateToken(refreshPayload.Username, refreshPayload.Role, s.accessTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(w, http.StatusOK, &RenewAccessTokenResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: accessPayload.ExpiredAt,
+	})
 }
 
 // APIFunc is a http.HanderFunc that returns an error
 type APIFunc func(w http.ResponseWriter, r *http.Request) error
 
-// APIError is a struct that represents a custom API error
+// FieldError describes a single field that failed struct validation.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// APIError is the JSON shape returned to API clients on failure. Code is a
+// machine-readable identifier (e.g. "validation_failed", "account_not_found")
+// that clients can switch on without parsing Message.
 type APIError struct {
-	Error string `json:"error"`
-	Code  int    `json:"code"`
+	Message string       `json:"message"`
+	Code    string       `json:"code"`
+	Fields  []FieldError `json:"fields,omitempty"`
+}
+
+// apiError is an error that carries the HTTP status and machine-readable
+// code it should be reported with, set by handlers that know precisely why
+// a request failed.
+type apiError struct {
+	status int
+	code   string
+	fields []FieldError
+	err    error
+}
+
+func (e *apiError) Error() string { return e.err.Error() }
+
+func newAPIError(status int, code string, err error) *apiError {
+	return &apiError{status: status, code: code, err: err}
+}
+
+// validateStruct validates s against its `validate` struct tags, returning
+// an apiError with one FieldError per failing field.
+func validateStruct(s any) error {
+	if err := validate.Struct(s); err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			fields := make([]FieldError, len(validationErrs))
+			for i, fe := range validationErrs {
+				fields[i] = FieldError{
+					Field:   fe.Field(),
+					Message: fmt.Sprintf("failed on the '%s' tag", fe.Tag()),
+				}
+			}
+			return &apiError{status: http.StatusBadRequest, code: "validation_failed", fields: fields, err: err}
+		}
+		return err
+	}
+	return nil
+}
+
+// mapError converts a handler error into an apiError, so makeHTTPHandlerFunc
+// can report a consistent status/code pair. Handlers that already return an
+// *apiError pass straight through; database errors are mapped to the
+// appropriate HTTP status; anything else falls back to a generic 400.
+func mapError(err error) *apiError {
+	var ae *apiError
+	if errors.As(err, &ae) {
+		return ae
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505": // unique_violation
+			return newAPIError(http.StatusConflict, "already_exists", err)
+		case "23503": // foreign_key_violation
+			return newAPIError(http.StatusUnprocessableEntity, "invalid_reference", err)
+		}
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return newAPIError(http.StatusNotFound, "not_found", err)
+	}
+
+	return newAPIError(http.StatusBadRequest, "bad_request", err)
 }
 
 // writeJSON writes a JSON response with the given status code and object
@@ -147,52 +367,51 @@ func writeJSON(w http.ResponseWriter, status int, v any) error {
 func makeHTTPHandlerFunc(apiFunc APIFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if err := apiFunc(w, r); err != nil {
-			// handle error here
-			writeJSON(w, http.StatusBadRequest, APIError{Error: err.Error()})
+			ae := mapError(err)
+			writeJSON(w, ae.status, APIError{Message: ae.Error(), Code: ae.code, Fields: ae.fields})
 		}
 	}
 }
 
-func validateJWT(tokenString string) (*jwt.Token, error) {
-	secret := os.Getenv("JWT_SECRET")
-	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
+// authPayloadKey is the context key under which withJWTAuth stores the
+// authenticated token payload.
+type authPayloadKey struct{}
 
-		// hmacSampleSecret is a []byte containing your secret, e.g. []byte("my_secret_key")
-		return []byte(secret), nil
-	})
+// authPayload returns the token payload withJWTAuth attached to the
+// request's context, so handlers can enforce ownership checks.
+func authPayload(r *http.Request) *token.Payload {
+	payload, _ := r.Context().Value(authPayloadKey{}).(*token.Payload)
+	return payload
 }
 
-func withJWTAuth(handlerFunc http.HandlerFunc) http.HandlerFunc {
+func (s *APIServer) withJWTAuth(handlerFunc http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		tokenString := r.Header.Get("x-jwt-token")
 
-		_, err := validateJWT(tokenString)
+		payload, err := s.tokenMaker.VerifyToken(tokenString)
 		if err != nil {
-			writeJSON(w, http.StatusForbidden, APIError{Error: "invalid token"})
+			writeJSON(w, http.StatusForbidden, APIError{Message: "invalid token", Code: "unauthorized"})
 			return
 		}
 
-		handlerFunc(w, r)
+		ctx := context.WithValue(r.Context(), authPayloadKey{}, payload)
+		handlerFunc(w, r.WithContext(ctx))
 	}
 }
 
-func createJWT(account *Account) (string, error) {
-	mySigningKey := []byte(os.Getenv("JWT_SECRET"))
-
-	// Create the Claims
-	claims := &jwt.MapClaims{
-		"expiresAt":     jwt.NewNumericDate(time.Unix(1516239022, 0)),
-		"accountNumber": account.Number,
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	ss, err := token.SignedString(mySigningKey)
-
-	return ss, err
+// authRoles wraps handlerFunc with withJWTAuth and additionally rejects
+// any request whose token payload's role is not one of roles.
+func (s *APIServer) authRoles(handlerFunc http.HandlerFunc, roles ...string) http.HandlerFunc {
+	return s.withJWTAuth(func(w http.ResponseWriter, r *http.Request) {
+		role := authPayload(r).Role
+		for _, allowed := range roles {
+			if role == allowed {
+				handlerFunc(w, r)
+				return
+			}
+		}
+		writeJSON(w, http.StatusForbidden, APIError{Message: "insufficient permissions for this role", Code: "unauthorized"})
+	})
 }
 
 // getID returns the id from the request
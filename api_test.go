@@ -0,0 +1,163 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/muhreeowki/go-bank-api/db/mock"
+	db "github.com/muhreeowki/go-bank-api/db/sqlc"
+	"github.com/muhreeowki/go-bank-api/token"
+)
+
+const testSymmetricKey = "12345678901234567890123456789012"
+
+func newTestServer(t *testing.T, store db.Store) *APIServer {
+	tokenMaker, err := token.NewPasetoMaker(testSymmetricKey)
+	require.NoError(t, err)
+
+	return &APIServer{
+		store:               store,
+		tokenMaker:          tokenMaker,
+		accessTokenDuration: time.Minute,
+	}
+}
+
+func addAuthHeader(t *testing.T, req *http.Request, tokenMaker token.TokenMaker, username, role string, duration time.Duration) {
+	accessToken, _, err := tokenMaker.CreateToken(username, role, duration)
+	require.NoError(t, err)
+	req.Header.Set("x-jwt-token", accessToken)
+}
+
+// TestAuthRolesListAccounts covers unauthorized and wrong-role access to the
+// banker-only GET /account endpoint.
+func TestAuthRolesListAccounts(t *testing.T) {
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, req *http.Request, server *APIServer)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "Unauthorized",
+			setupAuth: func(t *testing.T, req *http.Request, server *APIServer) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccounts(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "WrongRole",
+			setupAuth: func(t *testing.T, req *http.Request, server *APIServer) {
+				addAuthHeader(t, req, server.tokenMaker, "alice", RoleDepositor, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccounts(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, req *http.Request, server *APIServer) {
+				addAuthHeader(t, req, server.tokenMaker, "alice", RoleBanker, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccounts(gomock.Any()).Times(1).Return([]db.Account{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			handler := server.authRoles(makeHTTPHandlerFunc(server.handleGetAccounts), RoleBanker)
+
+			req := httptest.NewRequest(http.MethodGet, "/account", nil)
+			tc.setupAuth(t, req, server)
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, req)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestGetAccountByIDCrossUser covers a depositor attempting to read an
+// account owned by a different user.
+func TestGetAccountByIDCrossUser(t *testing.T) {
+	owner := db.Account{ID: 1, Owner: "bob", Balance: 100}
+
+	testCases := []struct {
+		name          string
+		accountID     int64
+		setupAuth     func(t *testing.T, req *http.Request, server *APIServer)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "Owner",
+			accountID: owner.ID,
+			setupAuth: func(t *testing.T, req *http.Request, server *APIServer) {
+				addAuthHeader(t, req, server.tokenMaker, "bob", RoleDepositor, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), owner.ID).Times(1).Return(owner, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "CrossUser",
+			accountID: owner.ID,
+			setupAuth: func(t *testing.T, req *http.Request, server *APIServer) {
+				addAuthHeader(t, req, server.tokenMaker, "alice", RoleDepositor, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), owner.ID).Times(1).Return(owner, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			handler := server.withJWTAuth(makeHTTPHandlerFunc(server.handleGetAccountByID))
+
+			req := httptest.NewRequest(http.MethodGet, "/account/1", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			tc.setupAuth(t, req, server)
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, req)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Package config loads the application's runtime configuration from an
+// app.env file and/or the environment.
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config holds all configuration for the application. The values are read
+// by viper from an env file or environment variables, matching the field's
+// mapstructure tag (e.g. DB_SOURCE).
+type Config struct {
+	DBDriver             string        `mapstructure:"DB_DRIVER"`
+	DBSource             string        `mapstructure:"DB_SOURCE"`
+	ServerAddress        string        `mapstructure:"SERVER_ADDRESS"`
+	TokenMaker           string        `mapstructure:"TOKEN_MAKER"`
+	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+}
+
+// Load reads configuration from app.env in path, falling back to and
+// letting environment variables of the same name override it.
+func Load(path string) (config Config, err error) {
+	viper.AddConfigPath(path)
+	viper.SetConfigName("app")
+	viper.SetConfigType("env")
+
+	viper.AutomaticEnv()
+
+	if err = viper.ReadInConfig(); err != nil {
+		return
+	}
+
+	err = viper.Unmarshal(&config)
+	return
+}
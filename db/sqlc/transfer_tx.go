@@ -0,0 +1,107 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrInsufficientFunds is returned by TransferTx when the source account's
+// balance is lower than the transfer amount.
+var ErrInsufficientFunds = errors.New("account has insufficient funds for this transfer")
+
+// ErrCurrencyMismatch is returned by TransferTx when Currency does not
+// match the currency of both the source and destination accounts.
+var ErrCurrencyMismatch = errors.New("transfer currency does not match account currency")
+
+// TransferTxParams contains the input parameters of the transfer transaction.
+type TransferTxParams struct {
+	FromAccountID int64   `json:"from_account_id"`
+	ToAccountID   int64   `json:"to_account_id"`
+	Amount        float64 `json:"amount"`
+	Currency      string  `json:"currency"`
+}
+
+// TransferTxResult is the result of the transfer transaction.
+type TransferTxResult struct {
+	Transfer    Transfer `json:"transfer"`
+	FromAccount Account  `json:"from_account"`
+	ToAccount   Account  `json:"to_account"`
+	FromEntry   Entry    `json:"from_entry"`
+	ToEntry     Entry    `json:"to_entry"`
+}
+
+// TransferTx performs a money transfer from one account to another. It
+// creates a transfer record, adds the corresponding debit/credit entries,
+// and updates both accounts' balances within a single transaction. The
+// account rows are locked in ascending ID order so that two concurrent
+// transfers between the same pair of accounts can never deadlock.
+func (s *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	if arg.FromAccountID == arg.ToAccountID {
+		return result, fmt.Errorf("cannot transfer to the same account")
+	}
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		firstID, secondID := arg.FromAccountID, arg.ToAccountID
+		if firstID > secondID {
+			firstID, secondID = secondID, firstID
+		}
+
+		first, err := q.GetAccountForUpdate(ctx, firstID)
+		if err != nil {
+			return err
+		}
+		second, err := q.GetAccountForUpdate(ctx, secondID)
+		if err != nil {
+			return err
+		}
+
+		fromAccount, toAccount := first, second
+		if firstID != arg.FromAccountID {
+			fromAccount, toAccount = second, first
+		}
+
+		if fromAccount.Currency != arg.Currency || toAccount.Currency != arg.Currency {
+			return ErrCurrencyMismatch
+		}
+
+		if fromAccount.Balance < arg.Amount {
+			return ErrInsufficientFunds
+		}
+
+		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   arg.ToAccountID,
+			Amount:        arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{AccountID: arg.FromAccountID, Amount: -arg.Amount})
+		if err != nil {
+			return err
+		}
+		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{AccountID: arg.ToAccountID, Amount: arg.Amount})
+		if err != nil {
+			return err
+		}
+
+		result.FromAccount, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{ID: arg.FromAccountID, Amount: -arg.Amount})
+		if err != nil {
+			return err
+		}
+		result.ToAccount, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{ID: arg.ToAccountID, Amount: arg.Amount})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	return result, err
+}
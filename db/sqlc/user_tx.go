@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+)
+
+// CreateUserTxParams contains the input parameters of the create-user
+// transaction.
+type CreateUserTxParams struct {
+	CreateUserParams
+}
+
+// CreateUserTxResult is the result of the create-user transaction.
+type CreateUserTxResult struct {
+	User    User
+	Account Account
+}
+
+// CreateUserTx signs a new user up and opens their first account within a
+// single transaction, so a failure partway through (e.g. the account's
+// owner FK) never leaves an orphaned users row.
+func (s *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error) {
+	var result CreateUserTxResult
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.CreateUser(ctx, arg.CreateUserParams)
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.CreateAccount(ctx, CreateAccountParams{
+			Owner:   result.User.Username,
+			Balance: 0,
+		})
+		return err
+	})
+
+	return result, err
+}
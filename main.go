@@ -0,0 +1,72 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/lib/pq"
+
+	"github.com/muhreeowki/go-bank-api/config"
+	db "github.com/muhreeowki/go-bank-api/db/sqlc"
+	"github.com/muhreeowki/go-bank-api/token"
+)
+
+func main() {
+	cfg, err := config.Load(".")
+	if err != nil {
+		log.Fatal("cannot load config: ", err)
+	}
+
+	conn, err := sql.Open(cfg.DBDriver, cfg.DBSource)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := conn.Ping(); err != nil {
+		log.Fatal(err)
+	}
+
+	runDBMigration("file://db/migration", cfg.DBSource)
+
+	store := db.NewStore(conn)
+
+	tokenMaker, err := newTokenMaker(cfg)
+	if err != nil {
+		log.Fatal("cannot create token maker: ", err)
+	}
+
+	server := NewAPIServer(cfg, store, tokenMaker)
+	server.Run()
+}
+
+// newTokenMaker builds the TokenMaker selected by cfg.TokenMaker
+// ("jwt" or "paseto").
+func newTokenMaker(cfg config.Config) (token.TokenMaker, error) {
+	switch cfg.TokenMaker {
+	case "jwt":
+		return token.NewJWTMaker(cfg.TokenSymmetricKey)
+	case "paseto":
+		return token.NewPasetoMaker(cfg.TokenSymmetricKey)
+	default:
+		return nil, fmt.Errorf("unknown token maker: %q", cfg.TokenMaker)
+	}
+}
+
+// runDBMigration brings the schema at dbSource up to date with the
+// migrations in sourceURL before the server starts serving requests.
+func runDBMigration(sourceURL, dbSource string) {
+	m, err := migrate.New(sourceURL, dbSource)
+	if err != nil {
+		log.Fatal("cannot create migration instance: ", err)
+	}
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		log.Fatal("failed to run migrate up: ", err)
+	}
+
+	log.Println("db migrated successfully")
+}
@@ -0,0 +1,96 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minSecretKeySize = 32
+
+// JWTMaker makes HS256 JSON Web Tokens.
+type JWTMaker struct {
+	secretKey string
+}
+
+// NewJWTMaker creates a new JWTMaker. secretKey must be at least
+// minSecretKeySize characters long.
+func NewJWTMaker(secretKey string) (*JWTMaker, error) {
+	if len(secretKey) < minSecretKeySize {
+		return nil, fmt.Errorf("invalid key size: must be at least %d characters", minSecretKeySize)
+	}
+	return &JWTMaker{secretKey}, nil
+}
+
+// jwtClaims adapts Payload to the jwt.Claims interface required by
+// golang-jwt, using the username as the subject.
+type jwtClaims struct {
+	*Payload
+}
+
+func (c jwtClaims) GetExpirationTime() (*jwt.NumericDate, error) {
+	return jwt.NewNumericDate(c.ExpiredAt), nil
+}
+
+func (c jwtClaims) GetIssuedAt() (*jwt.NumericDate, error) {
+	return jwt.NewNumericDate(c.IssuedAt), nil
+}
+
+func (c jwtClaims) GetNotBefore() (*jwt.NumericDate, error) {
+	return jwt.NewNumericDate(c.IssuedAt), nil
+}
+
+func (c jwtClaims) GetIssuer() (string, error) {
+	return "", nil
+}
+
+func (c jwtClaims) GetSubject() (string, error) {
+	return c.Username, nil
+}
+
+func (c jwtClaims) GetAudience() (jwt.ClaimStrings, error) {
+	return nil, nil
+}
+
+func (m *JWTMaker) CreateToken(username, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims{payload})
+	signed, err := token.SignedString([]byte(m.secretKey))
+	return signed, payload, err
+}
+
+func (m *JWTMaker) VerifyToken(token string) (*Payload, error) {
+	keyFunc := func(t *jwt.Token) (interface{}, error) {
+		return []byte(m.secretKey), nil
+	}
+
+	parsed, err := jwt.ParseWithClaims(
+		token,
+		&jwtClaims{Payload: &Payload{}},
+		keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := parsed.Claims.(*jwtClaims)
+	if !ok || !parsed.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	if err := claims.Payload.Valid(); err != nil {
+		return nil, err
+	}
+
+	return claims.Payload, nil
+}
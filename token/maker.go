@@ -0,0 +1,14 @@
+package token
+
+import "time"
+
+// TokenMaker is the interface for issuing and verifying access/refresh
+// tokens. JWTMaker and PasetoMaker are its two implementations.
+type TokenMaker interface {
+	// CreateToken creates a new token for the given user and role, valid
+	// for duration.
+	CreateToken(username, role string, duration time.Duration) (string, *Payload, error)
+	// VerifyToken checks that the token is valid and not expired, and
+	// returns its payload.
+	VerifyToken(token string) (*Payload, error)
+}
@@ -1,34 +1,67 @@
 package main
 
 import (
-	"math/rand"
 	"time"
-)
 
-type Account struct {
-	ID        int64     `json:"id"`
-	FirstName string    `json:"first_name"`
-	LastName  string    `json:"last_name"`
-	Number    int64     `json:"number"`
-	Balance   float64   `json:"balance"`
-	CreatedAt time.Time `json:"created_at"`
-}
+	db "github.com/muhreeowki/go-bank-api/db/sqlc"
+)
 
+// CreateAccountRequest signs a new user up and opens their first account
+// in a single call.
 type CreateAccountRequest struct {
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
+	Username string `json:"username" validate:"required,alphanum"`
+	FullName string `json:"full_name" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=6"`
 }
 
 type TransferRequest struct {
-	ToAccount int64 `json:"to_account"`
-	Amount    int64 `json:"amount"`
+	FromAccount int64   `json:"from_account" validate:"required,gt=0"`
+	ToAccount   int64   `json:"to_account" validate:"required,gt=0"`
+	Amount      float64 `json:"amount" validate:"required,gt=0"`
+	Currency    string  `json:"currency" validate:"required,oneof=USD EUR CAD"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+type LoginResponse struct {
+	AccessToken           string       `json:"access_token"`
+	AccessTokenExpiresAt  time.Time    `json:"access_token_expires_at"`
+	RefreshToken          string       `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time    `json:"refresh_token_expires_at"`
+	User                  UserResponse `json:"user"`
 }
 
-func NewAccount(FirstName, LastName string) *Account {
-	return &Account{
-		FirstName: FirstName,
-		LastName:  LastName,
-		Number:    int64(rand.Intn(1000000)),
-		CreatedAt: time.Now().UTC(),
+// UserResponse is the subset of db.User that is safe to return to a
+// client - in particular, it omits the hashed password.
+type UserResponse struct {
+	Username          string    `json:"username"`
+	FullName          string    `json:"full_name"`
+	Email             string    `json:"email"`
+	Role              string    `json:"role"`
+	PasswordChangedAt time.Time `json:"password_changed_at"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+func newUserResponse(user db.User) UserResponse {
+	return UserResponse{
+		Username:          user.Username,
+		FullName:          user.FullName,
+		Email:             user.Email,
+		Role:              user.Role,
+		PasswordChangedAt: user.PasswordChangedAt,
+		CreatedAt:         user.CreatedAt,
 	}
 }
+
+type RenewAccessTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type RenewAccessTokenResponse struct {
+	AccessToken          string    `json:"access_token"`
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+}